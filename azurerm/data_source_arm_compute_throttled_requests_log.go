@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmComputeThrottledRequestsLog() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmComputeThrottledRequestsLogRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": azure.SchemaLocation(),
+
+			"from_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"to_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"group_by_operation_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"group_by_resource_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"group_by_throttle_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"blob_container_sas_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"blob_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceArmComputeThrottledRequestsLogRead drives the ExportThrottledRequests LRO to
+// completion, matching the Future-polling pattern already used by the Compute throttling
+// export operations so the exported CSV's blob URI is available before the apply returns.
+func dataSourceArmComputeThrottledRequestsLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).compute.LogAnalyticsClient
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	fromTime, err := time.Parse(time.RFC3339, d.Get("from_time").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `from_time`: %+v", err)
+	}
+
+	toTime, err := time.Parse(time.RFC3339, d.Get("to_time").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `to_time`: %+v", err)
+	}
+
+	groupByOperationName := d.Get("group_by_operation_name").(bool)
+	groupByResourceName := d.Get("group_by_resource_name").(bool)
+	groupByThrottlePolicy := d.Get("group_by_throttle_policy").(bool)
+	blobContainerSasURI := d.Get("blob_container_sas_uri").(string)
+
+	input := compute.ThrottledRequestsInput{
+		BlobContainerSasURI:   &blobContainerSasURI,
+		FromTime:              &date.Time{Time: fromTime},
+		ToTime:                &date.Time{Time: toTime},
+		GroupByOperationName:  &groupByOperationName,
+		GroupByResourceName:   &groupByResourceName,
+		GroupByThrottlePolicy: &groupByThrottlePolicy,
+	}
+
+	future, err := client.ExportThrottledRequests(ctx, input, location)
+	if err != nil {
+		return fmt.Errorf("Error requesting Compute throttled-requests log export (Location %q): %+v", location, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Compute throttled-requests log export (Location %q) to complete: %+v", location, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Compute throttled-requests log export result (Location %q): %+v", location, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s", location, d.Get("from_time").(string), d.Get("to_time").(string)))
+
+	if properties := result.Properties; properties != nil && properties.Output != nil {
+		d.Set("blob_uri", properties.Output)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmHealthcareFhirImportJob() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmHealthcareFhirImportJobRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fhir_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"job_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resources_imported": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceArmHealthcareFhirImportJobRead polls the $import LRO to completion using the same
+// Future pattern HealthcareFhirServiceClient uses for CreateOrUpdate/Delete, so that a single
+// `terraform apply` blocks until the bulk ingest either finishes or the data source's read
+// timeout is hit.
+func dataSourceArmHealthcareFhirImportJobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareFhirServiceClient
+
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	fhirServiceID, err := parseAzureResourceID(d.Get("fhir_service_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := fhirServiceID.ResourceGroup
+	workspaceName := fhirServiceID.Path["workspaces"]
+	fhirServiceName := fhirServiceID.Path["fhirservices"]
+	jobID := d.Get("job_id").(string)
+
+	future, err := client.GetImportOperationStatus(ctx, resGroup, workspaceName, fhirServiceName, jobID)
+	if err != nil {
+		return fmt.Errorf("Error polling FHIR $import job %q (FHIR Service %q): %+v", jobID, fhirServiceName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for FHIR $import job %q (FHIR Service %q) to complete: %+v", jobID, fhirServiceName, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving result of FHIR $import job %q (FHIR Service %q): %+v", jobID, fhirServiceName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/importJobs/%s", fhirServiceID.ID, jobID))
+
+	if result.Status != "" {
+		d.Set("state", string(result.Status))
+	}
+
+	resourcesImported := 0
+	bytesProcessed := 0
+	errorsOutput := make([]interface{}, 0)
+
+	if result.Output != nil {
+		for _, output := range *result.Output {
+			if output.Count != nil {
+				resourcesImported += int(*output.Count)
+			}
+			if output.InputSize != nil {
+				bytesProcessed += int(*output.InputSize)
+			}
+		}
+	}
+
+	if result.Error != nil {
+		for _, importError := range *result.Error {
+			output := make(map[string]interface{})
+			if importError.URL != nil {
+				output["input_url"] = *importError.URL
+			}
+			if importError.ErrorURL != nil {
+				output["error_uri"] = *importError.ErrorURL
+			}
+			errorsOutput = append(errorsOutput, output)
+		}
+	}
+
+	d.Set("resources_imported", resourcesImported)
+	d.Set("bytes_processed", bytesProcessed)
+	if err := d.Set("errors", errorsOutput); err != nil {
+		return fmt.Errorf("Error setting `errors`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,425 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmHealthcareDicomService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareDicomServiceCreateUpdate,
+		Read:   resourceArmHealthcareDicomServiceRead,
+		Update: resourceArmHealthcareDicomServiceCreateUpdate,
+		Delete: resourceArmHealthcareDicomServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmHealthcareDicomServiceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(healthcareapis.SystemAssigned),
+								string(healthcareapis.UserAssigned),
+								string(healthcareapis.SystemAssignedUserAssigned),
+							}, false),
+						},
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"authentication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authority": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"audience": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"skip_authority_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"cors_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+						"allowed_headers": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"DELETE",
+									"GET",
+									"HEAD",
+									"MERGE",
+									"POST",
+									"OPTIONS",
+									"PUT"}, false),
+							},
+						},
+						"max_age_in_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 2000000000),
+						},
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareDicomServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareDicomServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Healthcare DICOM Service creation.")
+
+	name := d.Get("name").(string)
+	workspaceID, err := parseAzureResourceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := workspaceID.ResourceGroup
+	workspaceName := workspaceID.Path["workspaces"]
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, workspaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %s", name, workspaceName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_dicom_service", *existing.ID)
+		}
+	}
+
+	dicomService := healthcareapis.DicomService{
+		Location: utils.String(location),
+		Tags:     expandedTags,
+		Identity: expandAzureRMhealthcareapisIdentity(d.Get("identity").([]interface{})),
+		DicomServiceProperties: &healthcareapis.DicomServiceProperties{
+			AuthenticationConfiguration: expandHealthcareDicomServiceAuthentication(d),
+			CorsConfiguration:           expandHealthcareDicomServiceCorsConfiguration(d),
+			Encryption:                  expandAzureRMhealthcareapisEncryption(d),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, name, dicomService)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error Retrieving Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Healthcare DICOM Service %q (Workspace %q / resource group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareDicomServiceRead(d, meta)
+}
+
+func resourceArmHealthcareDicomServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareDicomServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["dicomservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Healthcare DICOM Service %q was not found (Workspace %q / Resource Group %q)", name, workspaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceResourceID(id.SubscriptionID, resourceGroup, workspaceName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenAzureRMhealthcareapisIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if properties := resp.DicomServiceProperties; properties != nil {
+		if err := d.Set("encryption", flattenAzureRMhealthcareapisEncryption(properties.Encryption, d)); err != nil {
+			return fmt.Errorf("Error setting `encryption`: %+v", err)
+		}
+
+		if properties.ServiceURL != nil {
+			d.Set("service_url", properties.ServiceURL)
+		}
+
+		authOutput := make([]interface{}, 0)
+		if authConfig := properties.AuthenticationConfiguration; authConfig != nil {
+			output := make(map[string]interface{})
+			if authConfig.Authority != nil {
+				output["authority"] = *authConfig.Authority
+			}
+			if authConfig.Audience != nil {
+				output["audience"] = *authConfig.Audience
+			}
+			authOutput = append(authOutput, output)
+		}
+		if err := d.Set("authentication_configuration", authOutput); err != nil {
+			return fmt.Errorf("Error setting `authentication_configuration`: %+v", authOutput)
+		}
+
+		corsOutput := make([]interface{}, 0)
+		if corsConfig := properties.CorsConfiguration; corsConfig != nil {
+			output := make(map[string]interface{})
+			if corsConfig.Origins != nil {
+				output["allowed_origins"] = *corsConfig.Origins
+			}
+			if corsConfig.Headers != nil {
+				output["allowed_headers"] = *corsConfig.Headers
+			}
+			if corsConfig.Methods != nil {
+				output["allowed_methods"] = *corsConfig.Methods
+			}
+			if corsConfig.MaxAge != nil {
+				output["max_age_in_seconds"] = *corsConfig.MaxAge
+			}
+			if corsConfig.AllowCredentials != nil {
+				output["allow_credentials"] = *corsConfig.AllowCredentials
+			}
+			corsOutput = append(corsOutput, output)
+		}
+		if err := d.Set("cors_configuration", corsOutput); err != nil {
+			return fmt.Errorf("Error setting `cors_configuration`: %+v", corsOutput)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareDicomServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareDicomServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error Parsing Azure Resource ID: %+v", err)
+	}
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["dicomservices"]
+
+	future, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deleting Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	return nil
+}
+
+// resourceArmHealthcareDicomServiceCustomizeDiff validates that `authority` points at a reachable,
+// HTTPS-only OIDC discovery document, mirroring resourceArmHealthcareServiceCustomizeDiff's check
+// for the legacy and FHIR services. DICOM always authenticates through Azure AD (there's no SMART
+// proxy toggle to gate on), so the check runs whenever `authentication_configuration` changes and
+// an `authority` is configured. Set `skip_authority_validation` to opt out, e.g. when the authority
+// isn't reachable from the machine running `terraform plan`.
+func resourceArmHealthcareDicomServiceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("authentication_configuration") {
+		return nil
+	}
+
+	authConfigRaw := d.Get("authentication_configuration").([]interface{})
+
+	for _, raw := range authConfigRaw {
+		attr := raw.(map[string]interface{})
+
+		if attr["skip_authority_validation"].(bool) {
+			continue
+		}
+
+		authority := attr["authority"].(string)
+		if authority == "" {
+			continue
+		}
+
+		if err := validateHealthcareAuthorityOpenIDConfiguration(authority); err != nil {
+			return fmt.Errorf("Error validating `authority` %q: %+v", authority, err)
+		}
+	}
+
+	return nil
+}
+
+func expandHealthcareDicomServiceCorsConfiguration(d *schema.ResourceData) *healthcareapis.DicomServiceCorsConfiguration {
+	corsConfigRaw := d.Get("cors_configuration").([]interface{})
+	if len(corsConfigRaw) == 0 {
+		return &healthcareapis.DicomServiceCorsConfiguration{}
+	}
+
+	attr := corsConfigRaw[0].(map[string]interface{})
+
+	allowedOrigins := *utils.ExpandStringSlice(attr["allowed_origins"].([]interface{}))
+	allowedHeaders := *utils.ExpandStringSlice(attr["allowed_headers"].([]interface{}))
+	allowedMethods := *utils.ExpandStringSlice(attr["allowed_methods"].([]interface{}))
+	maxAgeInSeconds := int32(attr["max_age_in_seconds"].(int))
+	allowCredentials := attr["allow_credentials"].(bool)
+
+	return &healthcareapis.DicomServiceCorsConfiguration{
+		Origins:          &allowedOrigins,
+		Headers:          &allowedHeaders,
+		Methods:          &allowedMethods,
+		MaxAge:           &maxAgeInSeconds,
+		AllowCredentials: &allowCredentials,
+	}
+}
+
+func expandHealthcareDicomServiceAuthentication(d *schema.ResourceData) *healthcareapis.DicomServiceAuthenticationConfiguration {
+	authConfigRaw := d.Get("authentication_configuration").([]interface{})
+	if len(authConfigRaw) == 0 {
+		return &healthcareapis.DicomServiceAuthenticationConfiguration{}
+	}
+
+	attr := authConfigRaw[0].(map[string]interface{})
+
+	authority := attr["authority"].(string)
+	audience := attr["audience"].(string)
+
+	return &healthcareapis.DicomServiceAuthenticationConfiguration{
+		Authority: &authority,
+		Audience:  &audience,
+	}
+}
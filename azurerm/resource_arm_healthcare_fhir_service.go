@@ -0,0 +1,513 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmHealthcareFhirService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareFhirServiceCreateUpdate,
+		Read:   resourceArmHealthcareFhirServiceRead,
+		Update: resourceArmHealthcareFhirServiceCreateUpdate,
+		Delete: resourceArmHealthcareFhirServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmHealthcareServiceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(healthcareapis.FhirServiceKindFhirR4),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(healthcareapis.FhirServiceKindFhirR4),
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(healthcareapis.SystemAssigned),
+								string(healthcareapis.UserAssigned),
+								string(healthcareapis.SystemAssignedUserAssigned),
+							}, false),
+						},
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"access_policy_object_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
+			"authentication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authority": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"audience": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"smart_proxy_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"skip_authority_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"cors_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+						"allowed_headers": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 64,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"DELETE",
+									"GET",
+									"HEAD",
+									"MERGE",
+									"POST",
+									"OPTIONS",
+									"PUT"}, false),
+							},
+						},
+						"max_age_in_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 2000000000),
+						},
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"import_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_account_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"initial_import_mode_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareFhirServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareFhirServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Healthcare FHIR Service creation.")
+
+	name := d.Get("name").(string)
+	workspaceID, err := parseAzureResourceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := workspaceID.ResourceGroup
+	workspaceName := workspaceID.Path["workspaces"]
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	kind := d.Get("kind").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, workspaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %s", name, workspaceName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_fhir_service", *existing.ID)
+		}
+	}
+
+	fhirService := healthcareapis.FhirService{
+		Location: utils.String(location),
+		Tags:     expandedTags,
+		Kind:     healthcareapis.FhirServiceKind(kind),
+		Identity: expandAzureRMhealthcareapisIdentity(d.Get("identity").([]interface{})),
+		FhirServiceProperties: &healthcareapis.FhirServiceProperties{
+			AccessPolicies:              expandHealthcareFhirServiceAccessPolicies(d),
+			CorsConfiguration:           expandHealthcareFhirServiceCorsConfiguration(d),
+			AuthenticationConfiguration: expandHealthcareFhirServiceAuthentication(d),
+			Encryption:                  expandAzureRMhealthcareapisEncryption(d),
+			ImportConfiguration:         expandHealthcareFhirServiceImportConfiguration(d),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, name, fhirService)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error Retrieving Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Healthcare FHIR Service %q (Workspace %q / resource group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareFhirServiceRead(d, meta)
+}
+
+func resourceArmHealthcareFhirServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareFhirServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["fhirservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Healthcare FHIR Service %q was not found (Workspace %q / Resource Group %q)", name, workspaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceResourceID(id.SubscriptionID, resourceGroup, workspaceName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+	if kind := resp.Kind; string(kind) != "" {
+		d.Set("kind", kind)
+	}
+
+	if err := d.Set("identity", flattenAzureRMhealthcareapisIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if properties := resp.FhirServiceProperties; properties != nil {
+		if config := properties.AccessPolicies; config != nil {
+			d.Set("access_policy_object_ids", flattenHealthcareFhirServiceAccessPolicies(config))
+		}
+
+		if err := d.Set("encryption", flattenAzureRMhealthcareapisEncryption(properties.Encryption, d)); err != nil {
+			return fmt.Errorf("Error setting `encryption`: %+v", err)
+		}
+
+		authOutput := make([]interface{}, 0)
+		if authConfig := properties.AuthenticationConfiguration; authConfig != nil {
+			output := make(map[string]interface{})
+			if authConfig.Authority != nil {
+				output["authority"] = *authConfig.Authority
+			}
+			if authConfig.Audience != nil {
+				output["audience"] = *authConfig.Audience
+			}
+			if authConfig.SmartProxyEnabled != nil {
+				output["smart_proxy_enabled"] = *authConfig.SmartProxyEnabled
+			}
+			authOutput = append(authOutput, output)
+		}
+		if err := d.Set("authentication_configuration", authOutput); err != nil {
+			return fmt.Errorf("Error setting `authentication_configuration`: %+v", authOutput)
+		}
+
+		corsOutput := make([]interface{}, 0)
+		if corsConfig := properties.CorsConfiguration; corsConfig != nil {
+			output := make(map[string]interface{})
+			if corsConfig.Origins != nil {
+				output["allowed_origins"] = *corsConfig.Origins
+			}
+			if corsConfig.Headers != nil {
+				output["allowed_headers"] = *corsConfig.Headers
+			}
+			if corsConfig.Methods != nil {
+				output["allowed_methods"] = *corsConfig.Methods
+			}
+			if corsConfig.MaxAge != nil {
+				output["max_age_in_seconds"] = *corsConfig.MaxAge
+			}
+			if corsConfig.AllowCredentials != nil {
+				output["allow_credentials"] = *corsConfig.AllowCredentials
+			}
+			corsOutput = append(corsOutput, output)
+		}
+		if err := d.Set("cors_configuration", corsOutput); err != nil {
+			return fmt.Errorf("Error setting `cors_configuration`: %+v", corsOutput)
+		}
+
+		importOutput := make([]interface{}, 0)
+		if importConfig := properties.ImportConfiguration; importConfig != nil {
+			output := make(map[string]interface{})
+			if importConfig.IntegrationDataStore != nil {
+				output["storage_account_name"] = *importConfig.IntegrationDataStore
+			}
+			if importConfig.Enabled != nil {
+				output["enabled"] = *importConfig.Enabled
+			}
+			if importConfig.InitialImportMode != nil {
+				output["initial_import_mode_enabled"] = *importConfig.InitialImportMode
+			}
+			importOutput = append(importOutput, output)
+		}
+		if err := d.Set("import_configuration", importOutput); err != nil {
+			return fmt.Errorf("Error setting `import_configuration`: %+v", importOutput)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareFhirServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareFhirServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error Parsing Azure Resource ID: %+v", err)
+	}
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["fhirservices"]
+
+	future, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deleting Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandHealthcareFhirServiceAccessPolicies(d *schema.ResourceData) *[]healthcareapis.FhirServiceAccessPolicyEntry {
+	objectIdsRaw := d.Get("access_policy_object_ids").([]interface{})
+	accessPolicies := make([]healthcareapis.FhirServiceAccessPolicyEntry, 0)
+
+	for _, objectId := range objectIdsRaw {
+		objectIdStr := objectId.(string)
+		accessPolicies = append(accessPolicies, healthcareapis.FhirServiceAccessPolicyEntry{ObjectID: &objectIdStr})
+	}
+
+	return &accessPolicies
+}
+
+func flattenHealthcareFhirServiceAccessPolicies(policies *[]healthcareapis.FhirServiceAccessPolicyEntry) []string {
+	result := make([]string, 0)
+	if policies == nil {
+		return result
+	}
+
+	for _, policy := range *policies {
+		if policy.ObjectID != nil {
+			result = append(result, *policy.ObjectID)
+		}
+	}
+
+	return result
+}
+
+func expandHealthcareFhirServiceCorsConfiguration(d *schema.ResourceData) *healthcareapis.FhirServiceCorsConfiguration {
+	corsConfigRaw := d.Get("cors_configuration").([]interface{})
+	if len(corsConfigRaw) == 0 {
+		return &healthcareapis.FhirServiceCorsConfiguration{}
+	}
+
+	attr := corsConfigRaw[0].(map[string]interface{})
+
+	allowedOrigins := *utils.ExpandStringSlice(attr["allowed_origins"].([]interface{}))
+	allowedHeaders := *utils.ExpandStringSlice(attr["allowed_headers"].([]interface{}))
+	allowedMethods := *utils.ExpandStringSlice(attr["allowed_methods"].([]interface{}))
+	maxAgeInSeconds := int32(attr["max_age_in_seconds"].(int))
+	allowCredentials := attr["allow_credentials"].(bool)
+
+	return &healthcareapis.FhirServiceCorsConfiguration{
+		Origins:          &allowedOrigins,
+		Headers:          &allowedHeaders,
+		Methods:          &allowedMethods,
+		MaxAge:           &maxAgeInSeconds,
+		AllowCredentials: &allowCredentials,
+	}
+}
+
+func expandHealthcareFhirServiceAuthentication(d *schema.ResourceData) *healthcareapis.FhirServiceAuthenticationConfiguration {
+	authConfigRaw := d.Get("authentication_configuration").([]interface{})
+	if len(authConfigRaw) == 0 {
+		return &healthcareapis.FhirServiceAuthenticationConfiguration{}
+	}
+
+	attr := authConfigRaw[0].(map[string]interface{})
+
+	authority := attr["authority"].(string)
+	audience := attr["audience"].(string)
+	smartProxyEnabled := attr["smart_proxy_enabled"].(bool)
+
+	return &healthcareapis.FhirServiceAuthenticationConfiguration{
+		Authority:         &authority,
+		Audience:          &audience,
+		SmartProxyEnabled: &smartProxyEnabled,
+	}
+}
+
+func workspaceResourceID(subscriptionID, resourceGroup, workspaceName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HealthcareApis/workspaces/%s", subscriptionID, resourceGroup, workspaceName)
+}
+
+func expandHealthcareFhirServiceImportConfiguration(d *schema.ResourceData) *healthcareapis.FhirServiceImportConfiguration {
+	importConfigRaw := d.Get("import_configuration").([]interface{})
+	if len(importConfigRaw) == 0 {
+		return &healthcareapis.FhirServiceImportConfiguration{}
+	}
+
+	attr := importConfigRaw[0].(map[string]interface{})
+
+	storageAccountName := attr["storage_account_name"].(string)
+	enabled := attr["enabled"].(bool)
+	initialImportMode := attr["initial_import_mode_enabled"].(bool)
+
+	return &healthcareapis.FhirServiceImportConfiguration{
+		IntegrationDataStore: &storageAccountName,
+		Enabled:              &enabled,
+		InitialImportMode:    &initialImportMode,
+	}
+}
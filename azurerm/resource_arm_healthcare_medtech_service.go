@@ -0,0 +1,308 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmHealthcareMedTechService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareMedTechServiceCreateUpdate,
+		Read:   resourceArmHealthcareMedTechServiceRead,
+		Update: resourceArmHealthcareMedTechServiceCreateUpdate,
+		Delete: resourceArmHealthcareMedTechServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(healthcareapis.SystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"eventhub_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"eventhub_consumer_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"device_mapping_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareMedTechServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Healthcare MedTech Service creation.")
+
+	name := d.Get("name").(string)
+	workspaceID, err := parseAzureResourceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := workspaceID.ResourceGroup
+	workspaceName := workspaceID.Path["workspaces"]
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, workspaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %s", name, workspaceName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_medtech_service", *existing.ID)
+		}
+	}
+
+	deviceMapping, err := expandHealthcareMedTechServiceDeviceMapping(d.Get("device_mapping_json").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `device_mapping_json`: %+v", err)
+	}
+
+	medTechService := healthcareapis.IotConnector{
+		Location: utils.String(location),
+		Tags:     expandedTags,
+		Identity: expandHealthcareMedTechServiceIdentity(d.Get("identity").([]interface{})),
+		IotConnectorProperties: &healthcareapis.IotConnectorProperties{
+			IngestionEndpointConfiguration: &healthcareapis.IotEventHubIngestionEndpointConfiguration{
+				EventHubName:                    utils.String(d.Get("eventhub_name").(string)),
+				ConsumerGroup:                   utils.String(d.Get("eventhub_consumer_group_name").(string)),
+				FullyQualifiedEventHubNamespace: utils.String(fmt.Sprintf("%s.servicebus.windows.net", d.Get("eventhub_namespace_name").(string))),
+			},
+			DeviceMapping: deviceMapping,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, name, medTechService)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error Retrieving Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Healthcare MedTech Service %q (Workspace %q / resource group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareMedTechServiceRead(d, meta)
+}
+
+func resourceArmHealthcareMedTechServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["iotconnectors"]
+
+	resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Healthcare MedTech Service %q was not found (Workspace %q / Resource Group %q)", name, workspaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceResourceID(id.SubscriptionID, resourceGroup, workspaceName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenHealthcareMedTechServiceIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if properties := resp.IotConnectorProperties; properties != nil {
+		if config := properties.IngestionEndpointConfiguration; config != nil {
+			if config.EventHubName != nil {
+				d.Set("eventhub_name", config.EventHubName)
+			}
+			if config.ConsumerGroup != nil {
+				d.Set("eventhub_consumer_group_name", config.ConsumerGroup)
+			}
+		}
+		if properties.DeviceMapping != nil {
+			mappingJSON, err := flattenHealthcareMedTechServiceDeviceMapping(properties.DeviceMapping)
+			if err != nil {
+				return fmt.Errorf("Error flattening `device_mapping_json`: %+v", err)
+			}
+			d.Set("device_mapping_json", mappingJSON)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareMedTechServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error Parsing Azure Resource ID: %+v", err)
+	}
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["iotconnectors"]
+
+	future, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deleting Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandHealthcareMedTechServiceIdentity(input []interface{}) *healthcareapis.ResourceIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &healthcareapis.ResourceIdentity{
+		Type: healthcareapis.ResourceIdentityType(v["type"].(string)),
+	}
+}
+
+func flattenHealthcareMedTechServiceIdentity(input *healthcareapis.ResourceIdentity) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	principalID := ""
+	if input.PrincipalID != nil {
+		principalID = *input.PrincipalID
+	}
+
+	tenantID := ""
+	if input.TenantID != nil {
+		tenantID = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"principal_id": principalID,
+			"tenant_id":    tenantID,
+		},
+	}
+}
+
+func expandHealthcareMedTechServiceDeviceMapping(input string) (*healthcareapis.IotMappingProperties, error) {
+	var content map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &content); err != nil {
+		return nil, err
+	}
+
+	return &healthcareapis.IotMappingProperties{
+		Content: content,
+	}, nil
+}
+
+func flattenHealthcareMedTechServiceDeviceMapping(input *healthcareapis.IotMappingProperties) (string, error) {
+	if input == nil || input.Content == nil {
+		return "", nil
+	}
+
+	contentJSON, err := json.Marshal(input.Content)
+	if err != nil {
+		return "", err
+	}
+
+	return string(contentJSON), nil
+}
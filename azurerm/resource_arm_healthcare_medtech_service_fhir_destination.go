@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmHealthcareMedTechServiceFhirDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareMedTechServiceFhirDestinationCreateUpdate,
+		Read:   resourceArmHealthcareMedTechServiceFhirDestinationRead,
+		Update: resourceArmHealthcareMedTechServiceFhirDestinationCreateUpdate,
+		Delete: resourceArmHealthcareMedTechServiceFhirDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"medtech_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"fhir_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"resource_identity_resolution_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(healthcareapis.IotIdentityResolutionTypeCreate),
+					string(healthcareapis.IotIdentityResolutionTypeLookup),
+				}, false),
+			},
+
+			"fhir_mapping_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func resourceArmHealthcareMedTechServiceFhirDestinationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceFhirDestinationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Healthcare MedTech Service FHIR Destination creation.")
+
+	name := d.Get("name").(string)
+	medTechServiceID, err := parseAzureResourceID(d.Get("medtech_service_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := medTechServiceID.ResourceGroup
+	workspaceName := medTechServiceID.Path["workspaces"]
+	iotConnectorName := medTechServiceID.Path["iotconnectors"]
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, workspaceName, iotConnectorName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %s", name, iotConnectorName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_medtech_service_fhir_destination", *existing.ID)
+		}
+	}
+
+	fhirMapping, err := expandHealthcareMedTechServiceDeviceMapping(d.Get("fhir_mapping_json").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `fhir_mapping_json`: %+v", err)
+	}
+
+	destination := healthcareapis.IotFhirDestination{
+		Location: utils.String(location),
+		IotFhirDestinationProperties: &healthcareapis.IotFhirDestinationProperties{
+			FhirServiceResourceID:          utils.String(d.Get("fhir_service_id").(string)),
+			ResourceIdentityResolutionType: healthcareapis.IotIdentityResolutionType(d.Get("resource_identity_resolution_type").(string)),
+			FhirMapping: &healthcareapis.IotMappingProperties{
+				Content: fhirMapping.Content,
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, iotConnectorName, name, destination)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error Creating/Updating Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, iotConnectorName, name)
+	if err != nil {
+		return fmt.Errorf("Error Retrieving Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Healthcare MedTech Service FHIR Destination %q (MedTech Service %q) ID", name, iotConnectorName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareMedTechServiceFhirDestinationRead(d, meta)
+}
+
+func resourceArmHealthcareMedTechServiceFhirDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceFhirDestinationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	iotConnectorName := id.Path["iotconnectors"]
+	name := id.Path["fhirdestinations"]
+
+	resp, err := client.Get(ctx, resourceGroup, workspaceName, iotConnectorName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Healthcare MedTech Service FHIR Destination %q was not found (MedTech Service %q)", name, iotConnectorName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+
+	d.Set("name", name)
+	d.Set("medtech_service_id", medTechServiceResourceID(id.SubscriptionID, resourceGroup, workspaceName, iotConnectorName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if properties := resp.IotFhirDestinationProperties; properties != nil {
+		if properties.FhirServiceResourceID != nil {
+			d.Set("fhir_service_id", properties.FhirServiceResourceID)
+		}
+		d.Set("resource_identity_resolution_type", string(properties.ResourceIdentityResolutionType))
+
+		if properties.FhirMapping != nil {
+			mappingJSON, err := flattenHealthcareMedTechServiceDeviceMapping(properties.FhirMapping)
+			if err != nil {
+				return fmt.Errorf("Error flattening `fhir_mapping_json`: %+v", err)
+			}
+			d.Set("fhir_mapping_json", mappingJSON)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmHealthcareMedTechServiceFhirDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).healthcare.HealthcareMedTechServiceFhirDestinationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error Parsing Azure Resource ID: %+v", err)
+	}
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	iotConnectorName := id.Path["iotconnectors"]
+	name := id.Path["fhirdestinations"]
+
+	future, err := client.Delete(ctx, resGroup, workspaceName, iotConnectorName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deleting Healthcare MedTech Service FHIR Destination %q (MedTech Service %q): %+v", name, iotConnectorName, err)
+	}
+
+	return nil
+}
+
+func medTechServiceResourceID(subscriptionID, resourceGroup, workspaceName, iotConnectorName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HealthcareApis/workspaces/%s/iotconnectors/%s", subscriptionID, resourceGroup, workspaceName, iotConnectorName)
+}
@@ -1,10 +1,14 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/healthcareapis/mgmt/2018-08-20-preview/healthcareapis"
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -24,6 +28,8 @@ func resourceArmHealthcareService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmHealthcareServiceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -48,6 +54,53 @@ func resourceArmHealthcareService() *schema.Resource {
 				Default:  1000,
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(healthcareapis.SystemAssigned),
+								string(healthcareapis.UserAssigned),
+								string(healthcareapis.SystemAssignedUserAssigned),
+							}, false),
+						},
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"access_policy_object_ids": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -71,11 +124,16 @@ func resourceArmHealthcareService() *schema.Resource {
 						"audience": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 						},
 						"smart_proxy_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"skip_authority_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -174,6 +232,7 @@ func resourceArmHealthcareServiceCreateUpdate(d *schema.ResourceData, meta inter
 		Location: utils.String(location),
 		Tags:     expandedTags,
 		Kind:     healthcareapis.Kind(kind),
+		Identity: expandAzureRMhealthcareapisIdentity(d.Get("identity").([]interface{})),
 		Properties: &healthcareapis.ServicesProperties{
 			AccessPolicies: expandAzureRMhealthcareapisAccessPolicyEntries(d),
 			CosmosDbConfiguration: &healthcareapis.ServiceCosmosDbConfigurationInfo{
@@ -181,6 +240,7 @@ func resourceArmHealthcareServiceCreateUpdate(d *schema.ResourceData, meta inter
 			},
 			CorsConfiguration:           expandAzureRMhealthcareapisCorsConfiguration(d),
 			AuthenticationConfiguration: expandAzureRMhealthcareapisAuthentication(d),
+			Encryption:                  expandAzureRMhealthcareapisEncryption(d),
 		},
 	}
 
@@ -237,6 +297,11 @@ func resourceArmHealthcareServiceRead(d *schema.ResourceData, meta interface{})
 	if kind := resp.Kind; string(kind) != "" {
 		d.Set("kind", kind)
 	}
+
+	if err := d.Set("identity", flattenAzureRMhealthcareapisIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	if properties := resp.Properties; properties != nil {
 		if config := properties.AccessPolicies; config != nil {
 			d.Set("access_policy_object_ids", flattenHealthcareAccessPolicies(config))
@@ -245,6 +310,10 @@ func resourceArmHealthcareServiceRead(d *schema.ResourceData, meta interface{})
 			d.Set("cosmosdb_throughput", config.OfferThroughput)
 		}
 
+		if err := d.Set("encryption", flattenAzureRMhealthcareapisEncryption(properties.Encryption, d)); err != nil {
+			return fmt.Errorf("Error setting `encryption`: %+v", err)
+		}
+
 		authOutput := make([]interface{}, 0)
 		if authConfig := properties.AuthenticationConfiguration; authConfig != nil {
 			output := make(map[string]interface{})
@@ -382,10 +451,187 @@ func expandAzureRMhealthcareapisAuthentication(d *schema.ResourceData) *healthca
 		smart_proxy_enabled = authConfigAttr["smart_proxy_enabled"].(bool)
 	}
 
+	if audience == "" {
+		audience = fmt.Sprintf("https://%s.azurehealthcareapis.com", d.Get("name").(string))
+	}
+
 	auth := &healthcareapis.ServiceAuthenticationConfigurationInfo{
 		Authority:         &authority,
 		Audience:          &audience,
 		SmartProxyEnabled: &smart_proxy_enabled,
 	}
 	return auth
-}
\ No newline at end of file
+}
+
+func expandAzureRMhealthcareapisIdentity(input []interface{}) *healthcareapis.ResourceIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	identity := &healthcareapis.ResourceIdentity{
+		Type: healthcareapis.ResourceIdentityType(v["type"].(string)),
+	}
+
+	if userAssignedIdentityID := v["user_assigned_identity_id"].(string); userAssignedIdentityID != "" {
+		identity.UserAssignedIdentities = map[string]*healthcareapis.ResourceIdentityUserAssignedIdentitiesValue{
+			userAssignedIdentityID: {},
+		}
+	}
+
+	return identity
+}
+
+func flattenAzureRMhealthcareapisIdentity(input *healthcareapis.ResourceIdentity) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	principalID := ""
+	if input.PrincipalID != nil {
+		principalID = *input.PrincipalID
+	}
+
+	tenantID := ""
+	if input.TenantID != nil {
+		tenantID = *input.TenantID
+	}
+
+	userAssignedIdentityID := ""
+	for id := range input.UserAssignedIdentities {
+		userAssignedIdentityID = id
+		break
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(input.Type),
+			"user_assigned_identity_id": userAssignedIdentityID,
+			"principal_id":              principalID,
+			"tenant_id":                 tenantID,
+		},
+	}
+}
+
+func expandAzureRMhealthcareapisEncryption(d *schema.ResourceData) *healthcareapis.ServicesEncryption {
+	encryptionRaw := d.Get("encryption").([]interface{})
+	if len(encryptionRaw) == 0 {
+		return nil
+	}
+
+	attr := encryptionRaw[0].(map[string]interface{})
+	keyVaultKeyID := attr["key_vault_key_id"].(string)
+
+	encryption := &healthcareapis.ServicesEncryption{
+		CustomerManagedKeyEncryption: &healthcareapis.ServicesEncryptionCustomerManagedKeyEncryption{
+			KeyEncryptionKeyURL: &keyVaultKeyID,
+		},
+	}
+
+	return encryption
+}
+
+// flattenAzureRMhealthcareapisEncryption reflects the (possibly rotated) key version back into state, but
+// preserves the configured value when the user supplied a versionless key_vault_key_id so that rotation
+// doesn't produce a spurious diff on every plan.
+func flattenAzureRMhealthcareapisEncryption(input *healthcareapis.ServicesEncryption, d *schema.ResourceData) []interface{} {
+	if input == nil || input.CustomerManagedKeyEncryption == nil || input.CustomerManagedKeyEncryption.KeyEncryptionKeyURL == nil {
+		return make([]interface{}, 0)
+	}
+
+	keyVaultKeyID := *input.CustomerManagedKeyEncryption.KeyEncryptionKeyURL
+
+	if existingRaw, ok := d.GetOk("encryption"); ok {
+		existing := existingRaw.([]interface{})
+		if len(existing) > 0 {
+			configured := existing[0].(map[string]interface{})["key_vault_key_id"].(string)
+			if configured != "" && strings.HasPrefix(keyVaultKeyID, strings.TrimSuffix(configured, "/")+"/") {
+				keyVaultKeyID = configured
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id": keyVaultKeyID,
+		},
+	}
+}
+
+// openIDConfiguration is the subset of the OIDC discovery document
+// (`.well-known/openid-configuration`) that SMART-on-FHIR clients rely on.
+type openIDConfiguration struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// resourceArmHealthcareServiceCustomizeDiff validates that `authority` points at a reachable,
+// HTTPS-only OIDC discovery document whenever `smart_proxy_enabled` is set, so a misconfigured
+// SMART-on-FHIR authority is caught at `terraform plan` rather than after the service's LRO
+// finishes and every client request is rejected at runtime. Set `skip_authority_validation` to
+// opt out, e.g. when the authority isn't reachable from the machine running `terraform plan`.
+// The outbound discovery request only runs when `authentication_configuration` actually changed,
+// so an unchanged, already-applied resource doesn't pay for a live HTTP round-trip on every plan.
+func resourceArmHealthcareServiceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("authentication_configuration") {
+		return nil
+	}
+
+	authConfigRaw := d.Get("authentication_configuration").([]interface{})
+
+	for _, raw := range authConfigRaw {
+		attr := raw.(map[string]interface{})
+
+		if !attr["smart_proxy_enabled"].(bool) || attr["skip_authority_validation"].(bool) {
+			continue
+		}
+
+		authority := attr["authority"].(string)
+		if authority == "" {
+			continue
+		}
+
+		if err := validateHealthcareAuthorityOpenIDConfiguration(authority); err != nil {
+			return fmt.Errorf("Error validating `authority` %q: %+v", authority, err)
+		}
+	}
+
+	return nil
+}
+
+func validateHealthcareAuthorityOpenIDConfiguration(authority string) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	discoveryURL := strings.TrimSuffix(authority, "/") + "/.well-known/openid-configuration"
+
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return fmt.Errorf("could not fetch OIDC discovery document from %q: %+v", discoveryURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OIDC discovery document at %q returned status %d", discoveryURL, resp.StatusCode)
+	}
+
+	var config openIDConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return fmt.Errorf("could not parse OIDC discovery document from %q: %+v", discoveryURL, err)
+	}
+
+	for name, endpoint := range map[string]string{
+		"authorization_endpoint": config.AuthorizationEndpoint,
+		"token_endpoint":         config.TokenEndpoint,
+		"jwks_uri":               config.JwksURI,
+	} {
+		if endpoint == "" {
+			return fmt.Errorf("OIDC discovery document at %q is missing `%s`", discoveryURL, name)
+		}
+		if !strings.HasPrefix(strings.ToLower(endpoint), "https://") {
+			return fmt.Errorf("OIDC discovery document at %q advertises a non-HTTPS `%s`: %q", discoveryURL, name, endpoint)
+		}
+	}
+
+	return nil
+}
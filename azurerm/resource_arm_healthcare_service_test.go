@@ -0,0 +1,99 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestExpandAzureRMhealthcareapisEncryption guards against silently dropping
+// key_vault_key_id from the CreateOrUpdate payload - the encryption block is
+// the only way to turn on CMK, so a regression here ships a resource that
+// looks configured but never actually enables encryption.
+func TestExpandAzureRMhealthcareapisEncryption(t *testing.T) {
+	raw := map[string]interface{}{
+		"encryption": []interface{}{
+			map[string]interface{}{
+				"key_vault_key_id": "https://example.vault.azure.net/keys/key1/abcd1234",
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceArmHealthcareService().Schema, raw)
+
+	encryption := expandAzureRMhealthcareapisEncryption(d)
+	if encryption == nil || encryption.CustomerManagedKeyEncryption == nil || encryption.CustomerManagedKeyEncryption.KeyEncryptionKeyURL == nil {
+		t.Fatal("expected a populated CustomerManagedKeyEncryption block")
+	}
+
+	if got := *encryption.CustomerManagedKeyEncryption.KeyEncryptionKeyURL; got != "https://example.vault.azure.net/keys/key1/abcd1234" {
+		t.Fatalf("expected key_vault_key_id %q, got %q", "https://example.vault.azure.net/keys/key1/abcd1234", got)
+	}
+}
+
+// TestFlattenAzureRMhealthcareapisEncryption locks in that the flattened
+// encryption block only ever contains fields that expand actually wires
+// into the API call - a previous version echoed a user_assigned_identity_id
+// straight out of config while expand silently ignored it, so plan showed
+// no diff even though the identity was never applied.
+func TestFlattenAzureRMhealthcareapisEncryption(t *testing.T) {
+	raw := map[string]interface{}{
+		"encryption": []interface{}{
+			map[string]interface{}{
+				"key_vault_key_id": "https://example.vault.azure.net/keys/key1",
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceArmHealthcareService().Schema, raw)
+
+	keyVaultKeyID := "https://example.vault.azure.net/keys/key1/abcd1234"
+	input := &healthcareapis.ServicesEncryption{
+		CustomerManagedKeyEncryption: &healthcareapis.ServicesEncryptionCustomerManagedKeyEncryption{
+			KeyEncryptionKeyURL: &keyVaultKeyID,
+		},
+	}
+
+	flattened := flattenAzureRMhealthcareapisEncryption(input, d)
+	if len(flattened) != 1 {
+		t.Fatalf("expected exactly one encryption block, got %d", len(flattened))
+	}
+
+	output := flattened[0].(map[string]interface{})
+	if _, ok := output["user_assigned_identity_id"]; ok {
+		t.Fatal("expected user_assigned_identity_id to be gone now that expand never wires it up")
+	}
+
+	if got := output["key_vault_key_id"].(string); got != "https://example.vault.azure.net/keys/key1" {
+		t.Fatalf("expected the versionless key_vault_key_id to be preserved, got %q", got)
+	}
+}
+
+// TestHealthcareServiceAudienceIsComputed would have caught the bug fixed by
+// 0e66cc5 and 177ee86: when the API populates `audience` server-side and the
+// field isn't Computed, terraform plan fights to reset it back to blank on
+// every run.
+func TestHealthcareServiceAudienceIsComputed(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_healthcare_service":       resourceArmHealthcareService(),
+		"azurerm_healthcare_fhir_service":  resourceArmHealthcareFhirService(),
+		"azurerm_healthcare_dicom_service": resourceArmHealthcareDicomService(),
+	}
+
+	for name, resource := range resources {
+		authConfig, ok := resource.Schema["authentication_configuration"]
+		if !ok {
+			t.Fatalf("%s: expected an authentication_configuration schema", name)
+		}
+
+		audience, ok := authConfig.Elem.(*schema.Resource).Schema["audience"]
+		if !ok {
+			t.Fatalf("%s: expected an audience field", name)
+		}
+
+		if !audience.Computed {
+			t.Errorf("%s: audience must be Computed, or a server-populated value fights every subsequent plan", name)
+		}
+	}
+}